@@ -1,6 +1,7 @@
 package application
 
 import (
+	"sync"
 	"sync/atomic"
 
 	"github.com/wailsapp/wails/exp/pkg/options"
@@ -25,23 +26,46 @@ type windowImpl interface {
 	isFullscreen() bool
 	restore()
 	setBackgroundColor(color *options.RGBA)
+	// emitToFrontend forwards a dispatched WindowEvent to the webview's runtime bridge so
+	// JS code can subscribe to it via `window.runtime.Window.On`.
+	emitToFrontend(event WindowEvent, payload any)
+	// emitMessageToFrontend forwards a named message emitted via Emit/EmitTo to the webview's
+	// runtime bridge so JS code can subscribe to it via `window.runtime.Window.Listen`.
+	emitMessageToFrontend(event string, payload any)
+	// close tears down the native window.
+	close()
 }
 
 type Window struct {
 	options *options.Window
 	impl    windowImpl
 	id      uint64
+
+	eventsLock     sync.RWMutex
+	eventListeners map[WindowEvent][]eventListener
+	closeHandlers  []func() bool
+	nextListenerID uint64
+
+	messagesLock    sync.RWMutex
+	messageHandlers map[string][]func(payload any)
 }
 
 var windowID atomic.Uint64
 
+// NewWindow creates a new Window and registers it with the package-level WindowManager
+// returned by Windows(), so it can be looked up by label and messaged from other windows.
+// options.Label must be unique among currently registered windows; NewWindow panics otherwise.
 func NewWindow(options *options.Window) *Window {
 	id := windowID.Load()
 	windowID.Add(1)
-	return &Window{
+	w := &Window{
 		id:      id,
 		options: options,
 	}
+	if err := Windows().register(w); err != nil {
+		panic(err)
+	}
+	return w
 }
 
 func (w *Window) SetTitle(title string) {
@@ -62,7 +86,9 @@ func (w *Window) SetSize(width, height int) {
 }
 
 func (w *Window) Run() error {
-	w.impl = newWindowImpl(w.options)
+	// w is passed to the platform backend so it can push native lifecycle and input events
+	// into the Go-side dispatcher via w.dispatch.
+	w.impl = newWindowImpl(w)
 	return w.impl.run()
 }
 
@@ -194,3 +220,23 @@ func (w *Window) SetBackgroundColor(color *options.RGBA) {
 	}
 	w.impl.setBackgroundColor(color)
 }
+
+// Label returns the unique label this window was registered with.
+func (w *Window) Label() string {
+	return w.options.Label
+}
+
+// Close closes the window, unless a handler registered with OnCloseRequested prevents it.
+// It also unregisters the window from the package-level WindowManager.
+func (w *Window) Close() {
+	if w.shouldPreventClose() {
+		return
+	}
+	// Dispatch Destroyed before tearing down the native window, so the frontend runtime
+	// bridge still has a live webview to deliver the event to.
+	w.dispatch(WindowEventDestroyed, nil)
+	if w.impl != nil {
+		w.impl.close()
+	}
+	Windows().unregister(w)
+}
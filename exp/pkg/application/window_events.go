@@ -0,0 +1,140 @@
+package application
+
+// WindowEvent identifies a lifecycle or input event emitted by a Window.
+type WindowEvent int
+
+const (
+	// WindowEventResized fires when the window's size changes. The payload is a ResizeEvent.
+	WindowEventResized WindowEvent = iota
+	// WindowEventMoved fires when the window's position changes. The payload is a MoveEvent.
+	WindowEventMoved
+	// WindowEventFocused fires when the window gains keyboard focus.
+	WindowEventFocused
+	// WindowEventBlurred fires when the window loses keyboard focus.
+	WindowEventBlurred
+	// WindowEventCloseRequested fires when the user or OS attempts to close the window.
+	// Register a handler with Window.OnCloseRequested to veto the close.
+	WindowEventCloseRequested
+	// WindowEventScaleFactorChanged fires when the window moves to a display with a different
+	// DPI scale factor. The payload is a ScaleFactorChangedEvent.
+	WindowEventScaleFactorChanged
+	// WindowEventThemeChanged fires when the OS switches between light and dark mode.
+	WindowEventThemeChanged
+	// WindowEventDomReady fires once the webview has finished loading the DOM.
+	WindowEventDomReady
+	// WindowEventDestroyed fires once the window is about to be closed, before its native
+	// resources are released.
+	WindowEventDestroyed
+)
+
+// windowEventNames gives each WindowEvent a stable wire name, so the value sent to the frontend
+// runtime bridge (and to JS) doesn't depend on iota ordering.
+var windowEventNames = map[WindowEvent]string{
+	WindowEventResized:            "resized",
+	WindowEventMoved:              "moved",
+	WindowEventFocused:            "focused",
+	WindowEventBlurred:            "blurred",
+	WindowEventCloseRequested:     "close-requested",
+	WindowEventScaleFactorChanged: "scale-factor-changed",
+	WindowEventThemeChanged:       "theme-changed",
+	WindowEventDomReady:           "dom-ready",
+	WindowEventDestroyed:          "destroyed",
+}
+
+// String returns the stable wire name for event, used when marshaling it to the frontend
+// runtime bridge.
+func (e WindowEvent) String() string {
+	if name, ok := windowEventNames[e]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ResizeEvent is the payload delivered with WindowEventResized.
+type ResizeEvent struct {
+	Width  int
+	Height int
+}
+
+// MoveEvent is the payload delivered with WindowEventMoved.
+type MoveEvent struct {
+	X int
+	Y int
+}
+
+// ScaleFactorChangedEvent is the payload delivered with WindowEventScaleFactorChanged.
+type ScaleFactorChangedEvent struct {
+	ScaleFactor float64
+}
+
+// eventListener pairs a registered handler with the id used to unsubscribe it.
+type eventListener struct {
+	id      uint64
+	handler func(payload any)
+}
+
+// On registers handler to be called whenever event occurs on the window. It returns an
+// unsubscribe function that removes the handler.
+func (w *Window) On(event WindowEvent, handler func(payload any)) (unsubscribe func()) {
+	w.eventsLock.Lock()
+	defer w.eventsLock.Unlock()
+
+	if w.eventListeners == nil {
+		w.eventListeners = make(map[WindowEvent][]eventListener)
+	}
+	w.nextListenerID++
+	id := w.nextListenerID
+	w.eventListeners[event] = append(w.eventListeners[event], eventListener{id: id, handler: handler})
+
+	return func() {
+		w.eventsLock.Lock()
+		defer w.eventsLock.Unlock()
+		listeners := w.eventListeners[event]
+		for i, l := range listeners {
+			if l.id == id {
+				w.eventListeners[event] = append(listeners[:i], listeners[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// OnCloseRequested registers a handler that is invoked when the window is about to close.
+// If handler returns true, the close is prevented, analogous to the application-level ShouldQuit hook.
+func (w *Window) OnCloseRequested(handler func() (prevent bool)) {
+	w.eventsLock.Lock()
+	defer w.eventsLock.Unlock()
+	w.closeHandlers = append(w.closeHandlers, handler)
+}
+
+// dispatch runs the handlers registered for event with the given payload, and forwards the
+// event to the frontend runtime bridge so JS listeners receive it too.
+func (w *Window) dispatch(event WindowEvent, payload any) {
+	w.eventsLock.RLock()
+	listeners := append([]eventListener(nil), w.eventListeners[event]...)
+	w.eventsLock.RUnlock()
+
+	for _, l := range listeners {
+		l.handler(payload)
+	}
+
+	if w.impl != nil {
+		w.impl.emitToFrontend(event, payload)
+	}
+}
+
+// shouldPreventClose runs the registered OnCloseRequested handlers and reports whether the
+// close should be prevented.
+func (w *Window) shouldPreventClose() bool {
+	w.eventsLock.RLock()
+	handlers := append([]func() bool(nil), w.closeHandlers...)
+	w.eventsLock.RUnlock()
+
+	prevent := false
+	for _, handler := range handlers {
+		if handler() {
+			prevent = true
+		}
+	}
+	return prevent
+}
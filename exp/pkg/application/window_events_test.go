@@ -0,0 +1,86 @@
+package application
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestWindowOnAndDispatch(t *testing.T) {
+	w := &Window{}
+
+	var got ResizeEvent
+	w.On(WindowEventResized, func(payload any) {
+		got = payload.(ResizeEvent)
+	})
+
+	w.dispatch(WindowEventResized, ResizeEvent{Width: 800, Height: 600})
+
+	if got != (ResizeEvent{Width: 800, Height: 600}) {
+		t.Fatalf("handler received %+v, want {800 600}", got)
+	}
+}
+
+func TestWindowOnUnsubscribe(t *testing.T) {
+	w := &Window{}
+
+	calls := 0
+	unsubscribe := w.On(WindowEventFocused, func(payload any) {
+		calls++
+	})
+
+	w.dispatch(WindowEventFocused, nil)
+	unsubscribe()
+	w.dispatch(WindowEventFocused, nil)
+
+	if calls != 1 {
+		t.Fatalf("handler called %d times, want 1 (second dispatch should have been ignored after unsubscribe)", calls)
+	}
+}
+
+func TestWindowOnUnsubscribeOnlyRemovesItsOwnHandler(t *testing.T) {
+	w := &Window{}
+
+	var firstCalls, secondCalls int
+	unsubscribeFirst := w.On(WindowEventBlurred, func(payload any) { firstCalls++ })
+	w.On(WindowEventBlurred, func(payload any) { secondCalls++ })
+
+	unsubscribeFirst()
+	w.dispatch(WindowEventBlurred, nil)
+
+	if firstCalls != 0 {
+		t.Fatalf("first handler called %d times after unsubscribing, want 0", firstCalls)
+	}
+	if secondCalls != 1 {
+		t.Fatalf("second handler called %d times, want 1", secondCalls)
+	}
+}
+
+func TestWindowOnCloseRequestedPreventsClose(t *testing.T) {
+	w := &Window{}
+
+	w.OnCloseRequested(func() bool { return false })
+	w.OnCloseRequested(func() bool { return true })
+
+	if !w.shouldPreventClose() {
+		t.Fatalf("shouldPreventClose() = false, want true since one handler requested prevention")
+	}
+}
+
+func TestWindowOnConcurrentSubscribeAndDispatch(t *testing.T) {
+	w := &Window{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			unsubscribe := w.On(WindowEventMoved, func(payload any) {})
+			unsubscribe()
+		}()
+		go func() {
+			defer wg.Done()
+			w.dispatch(WindowEventMoved, MoveEvent{X: 1, Y: 2})
+		}()
+	}
+	wg.Wait()
+}
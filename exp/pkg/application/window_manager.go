@@ -0,0 +1,118 @@
+package application
+
+import (
+	"fmt"
+	"sync"
+)
+
+// WindowManager owns every Window created via NewWindow, keyed by its unique label. It allows
+// windows to be discovered and messaged from anywhere in the application, modelled on Tauri's
+// WindowManager.
+type WindowManager struct {
+	lock    sync.RWMutex
+	windows map[string]*Window
+	order   []*Window
+}
+
+var windowManager = &WindowManager{
+	windows: make(map[string]*Window),
+}
+
+// Windows returns the package-level WindowManager that every Window created via NewWindow is
+// registered with.
+func Windows() *WindowManager {
+	return windowManager
+}
+
+// register adds w to the registry, keyed by its label. It returns an error if another window is
+// already registered under the same label.
+func (m *WindowManager) register(w *Window) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if existing, exists := m.windows[w.options.Label]; exists && existing != w {
+		return fmt.Errorf("window label %q is already registered", w.options.Label)
+	}
+	m.windows[w.options.Label] = w
+	m.order = append(m.order, w)
+	return nil
+}
+
+func (m *WindowManager) unregister(w *Window) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	// Only remove the label entry if it still points at w, so unregistering w never deletes
+	// another window's entry under the same label.
+	if m.windows[w.options.Label] == w {
+		delete(m.windows, w.options.Label)
+	}
+	for i, candidate := range m.order {
+		if candidate == w {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// GetByLabel returns the window registered with the given label, or nil if no such window exists.
+func (m *WindowManager) GetByLabel(label string) *Window {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.windows[label]
+}
+
+// All returns every currently registered window.
+func (m *WindowManager) All() []*Window {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return append([]*Window(nil), m.order...)
+}
+
+// Close closes the window registered with the given label, if one exists.
+func (m *WindowManager) Close(label string) {
+	if w := m.GetByLabel(label); w != nil {
+		w.Close()
+	}
+}
+
+// Emit delivers event with the given payload to every registered window.
+func (m *WindowManager) Emit(event string, payload any) {
+	for _, w := range m.All() {
+		w.receive(event, payload)
+	}
+}
+
+// EmitTo delivers event with the given payload to the single window registered with label.
+func (m *WindowManager) EmitTo(label string, event string, payload any) {
+	if w := m.GetByLabel(label); w != nil {
+		w.receive(event, payload)
+	}
+}
+
+// Listen registers handler to be called whenever event is delivered to this window via
+// WindowManager.Emit or WindowManager.EmitTo, whether sent from Go or from another window's
+// frontend. Unlike On, which subscribes to native lifecycle events, Listen subscribes to
+// arbitrary named messages used for cross-window communication.
+func (w *Window) Listen(event string, handler func(payload any)) {
+	w.messagesLock.Lock()
+	defer w.messagesLock.Unlock()
+	if w.messageHandlers == nil {
+		w.messageHandlers = make(map[string][]func(payload any))
+	}
+	w.messageHandlers[event] = append(w.messageHandlers[event], handler)
+}
+
+// receive runs the handlers registered for event via Listen and forwards it to the frontend
+// runtime bridge.
+func (w *Window) receive(event string, payload any) {
+	w.messagesLock.RLock()
+	handlers := append([]func(payload any){}, w.messageHandlers[event]...)
+	w.messagesLock.RUnlock()
+
+	for _, handler := range handlers {
+		handler(payload)
+	}
+
+	if w.impl != nil {
+		w.impl.emitMessageToFrontend(event, payload)
+	}
+}
@@ -0,0 +1,51 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/wailsapp/wails/exp/pkg/options"
+)
+
+func newTestWindow(label string) *Window {
+	return &Window{options: &options.Window{Label: label}}
+}
+
+func TestWindowManagerRegisterRejectsDuplicateLabel(t *testing.T) {
+	m := &WindowManager{windows: make(map[string]*Window)}
+
+	first := newTestWindow("main")
+	if err := m.register(first); err != nil {
+		t.Fatalf("register(first) returned unexpected error: %v", err)
+	}
+
+	second := newTestWindow("main")
+	if err := m.register(second); err == nil {
+		t.Fatalf("register(second) succeeded, want error because label %q is already registered", "main")
+	}
+
+	if got := m.GetByLabel("main"); got != first {
+		t.Fatalf("GetByLabel(%q) = %p, want the first window (%p)", "main", got, first)
+	}
+}
+
+func TestWindowManagerUnregisterDoesNotClobberNewerWindow(t *testing.T) {
+	m := &WindowManager{windows: make(map[string]*Window)}
+
+	older := newTestWindow("main")
+	if err := m.register(older); err != nil {
+		t.Fatalf("register(older) returned unexpected error: %v", err)
+	}
+
+	// register rejects a second window under the same label, so simulate a label entry that
+	// points at a different *Window than the one first registered by overwriting the map entry
+	// directly.
+	newer := newTestWindow("main")
+	m.windows["main"] = newer
+	m.order = append(m.order, newer)
+
+	m.unregister(older)
+
+	if got := m.GetByLabel("main"); got != newer {
+		t.Fatalf("GetByLabel(%q) = %p after unregistering an older, already-overwritten window; want the still-open window (%p) to remain reachable", "main", got, newer)
+	}
+}
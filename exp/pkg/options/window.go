@@ -0,0 +1,49 @@
+package options
+
+// WindowState is the state a window should start in, or be restored to.
+type WindowState int
+
+const (
+	// WindowStateNormal is the default window state.
+	WindowStateNormal WindowState = iota
+	// WindowStateMaximised starts the window maximised.
+	WindowStateMaximised
+	// WindowStateMinimised starts the window minimised.
+	WindowStateMinimised
+	// WindowStateFullscreen starts the window in fullscreen mode.
+	WindowStateFullscreen
+)
+
+// RGBA describes a colour with an alpha channel.
+type RGBA struct {
+	R uint8
+	G uint8
+	B uint8
+	A uint8
+}
+
+// Window contains the options for a single Window.
+type Window struct {
+	// Label uniquely identifies the window within the application. It is used to look the
+	// window up via application.Windows().GetByLabel and to target it with EmitTo.
+	Label string
+
+	Title string
+
+	Width  int
+	Height int
+
+	MinWidth  int
+	MinHeight int
+
+	DisableResize bool
+	AlwaysOnTop   bool
+
+	StartState WindowState
+
+	URL string
+
+	EnableDevTools bool
+
+	BackgroundColour *RGBA
+}
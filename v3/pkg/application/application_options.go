@@ -130,6 +130,16 @@ type AssetOptions struct {
 
 	// DisableLogging disables logging of the AssetServer. By default, the AssetServer logs every request.
 	DisableLogging bool
+
+	// CustomSchemes registers additional URI schemes (e.g. "db", "media", "plugin-xyz") that are
+	// intercepted by the WebView and routed to the given http.Handler instead of being treated as
+	// a normal web request. This allows large payloads (video, database blobs) to be streamed
+	// directly to the WebView without being squeezed through the IPC channel.
+	//
+	// CustomSchemes set here are only applied to the WebviewWindow created with these
+	// AssetOptions as WebviewWindowOptions.Assets. To register a scheme on an already-running
+	// window, use WebviewWindow.RegisterScheme instead.
+	CustomSchemes map[string]http.Handler
 }
 
 // Middleware defines HTTP middleware that can be applied to the AssetServer.
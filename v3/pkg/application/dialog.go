@@ -0,0 +1,95 @@
+package application
+
+// dialogImpl is implemented once per platform: NSOpenPanel/NSSavePanel/NSAlert on macOS,
+// IFileDialog/TaskDialog on Windows, and GtkFileChooser/GtkMessageDialog on Linux.
+type dialogImpl interface {
+	openFile(opts OpenDialogOptions) ([]string, error)
+	saveFile(opts SaveDialogOptions) (string, error)
+	selectDirectory(opts OpenDialogOptions) ([]string, error)
+	messageBox(opts MessageDialogOptions) (int, error)
+}
+
+// FileFilter restricts the files shown in an OpenFile or SaveFile dialog.
+type FileFilter struct {
+	// Name is the label shown for this filter, e.g. "Images".
+	Name string
+	// Extensions is the list of extensions this filter matches, e.g. []string{"png", "jpg"}.
+	Extensions []string
+}
+
+// OpenDialogOptions configures a call to OpenFile or SelectDirectory.
+type OpenDialogOptions struct {
+	Title       string
+	DefaultPath string
+	Filters     []FileFilter
+
+	AllowsMultipleSelection bool
+	CanCreateDirectories    bool
+	ShowHiddenFiles         bool
+	CanChooseDirectories    bool
+}
+
+// SaveDialogOptions configures a call to SaveFile.
+type SaveDialogOptions struct {
+	Title                string
+	DefaultPath          string
+	Filters              []FileFilter
+	CanCreateDirectories bool
+	ShowHiddenFiles      bool
+}
+
+// MessageDialogButtonType is the set of buttons offered by a MessageBox.
+type MessageDialogButtonType int
+
+const (
+	// InfoDialogType shows an informational message with a single "OK" button.
+	InfoDialogType MessageDialogButtonType = iota
+	// WarningDialogType shows a warning message with a single "OK" button.
+	WarningDialogType
+	// ErrorDialogType shows an error message with a single "OK" button.
+	ErrorDialogType
+	// QuestionDialogType shows a question with "Yes"/"No" buttons.
+	QuestionDialogType
+)
+
+// MessageDialogOptions configures a call to MessageBox.
+type MessageDialogOptions struct {
+	Type    MessageDialogButtonType
+	Title   string
+	Message string
+	Buttons []string
+}
+
+// Dialog gives access to the native OS dialogs: file/folder pickers, save dialogs, and message
+// boxes.
+type Dialog struct {
+	impl dialogImpl
+}
+
+// NewDialog creates a new Dialog backed by the current platform's native dialogs.
+func NewDialog() *Dialog {
+	return &Dialog{impl: newDialogImpl()}
+}
+
+// OpenFile shows a native file picker and returns the selected file paths, or nil if the user
+// cancelled. Multiple paths are only returned if opts.AllowsMultipleSelection is set.
+func (d *Dialog) OpenFile(opts OpenDialogOptions) ([]string, error) {
+	return d.impl.openFile(opts)
+}
+
+// SaveFile shows a native save dialog and returns the chosen path, or an empty string if the
+// user cancelled.
+func (d *Dialog) SaveFile(opts SaveDialogOptions) (string, error) {
+	return d.impl.saveFile(opts)
+}
+
+// SelectDirectory shows a native folder picker and returns the selected directory paths, or nil
+// if the user cancelled.
+func (d *Dialog) SelectDirectory(opts OpenDialogOptions) ([]string, error) {
+	return d.impl.selectDirectory(opts)
+}
+
+// MessageBox shows a native message box and returns the index of the button the user clicked.
+func (d *Dialog) MessageBox(opts MessageDialogOptions) (int, error) {
+	return d.impl.messageBox(opts)
+}
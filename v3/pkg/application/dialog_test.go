@@ -0,0 +1,107 @@
+package application
+
+import "testing"
+
+type fakeDialogImpl struct {
+	openFilePaths []string
+	openFileErr   error
+
+	saveFilePath string
+	saveFileErr  error
+
+	selectDirectoryPaths []string
+	selectDirectoryErr   error
+
+	messageBoxIndex int
+	messageBoxErr   error
+
+	lastOpenOpts    OpenDialogOptions
+	lastSaveOpts    SaveDialogOptions
+	lastSelectOpts  OpenDialogOptions
+	lastMessageOpts MessageDialogOptions
+}
+
+func (f *fakeDialogImpl) openFile(opts OpenDialogOptions) ([]string, error) {
+	f.lastOpenOpts = opts
+	return f.openFilePaths, f.openFileErr
+}
+
+func (f *fakeDialogImpl) saveFile(opts SaveDialogOptions) (string, error) {
+	f.lastSaveOpts = opts
+	return f.saveFilePath, f.saveFileErr
+}
+
+func (f *fakeDialogImpl) selectDirectory(opts OpenDialogOptions) ([]string, error) {
+	f.lastSelectOpts = opts
+	return f.selectDirectoryPaths, f.selectDirectoryErr
+}
+
+func (f *fakeDialogImpl) messageBox(opts MessageDialogOptions) (int, error) {
+	f.lastMessageOpts = opts
+	return f.messageBoxIndex, f.messageBoxErr
+}
+
+func TestDialogOpenFile(t *testing.T) {
+	impl := &fakeDialogImpl{openFilePaths: []string{"/tmp/a.txt", "/tmp/b.txt"}}
+	d := &Dialog{impl: impl}
+
+	opts := OpenDialogOptions{Title: "Pick files", AllowsMultipleSelection: true}
+	paths, err := d.OpenFile(opts)
+	if err != nil {
+		t.Fatalf("OpenFile returned unexpected error: %v", err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("OpenFile returned %v, want 2 paths", paths)
+	}
+	if impl.lastOpenOpts.Title != opts.Title || impl.lastOpenOpts.AllowsMultipleSelection != opts.AllowsMultipleSelection {
+		t.Fatalf("openFile received %+v, want %+v", impl.lastOpenOpts, opts)
+	}
+}
+
+func TestDialogSaveFile(t *testing.T) {
+	impl := &fakeDialogImpl{saveFilePath: "/tmp/out.txt"}
+	d := &Dialog{impl: impl}
+
+	path, err := d.SaveFile(SaveDialogOptions{Title: "Save as"})
+	if err != nil {
+		t.Fatalf("SaveFile returned unexpected error: %v", err)
+	}
+	if path != "/tmp/out.txt" {
+		t.Fatalf("SaveFile() = %q, want %q", path, "/tmp/out.txt")
+	}
+}
+
+func TestDialogSelectDirectory(t *testing.T) {
+	impl := &fakeDialogImpl{selectDirectoryPaths: []string{"/tmp/dir"}}
+	d := &Dialog{impl: impl}
+
+	paths, err := d.SelectDirectory(OpenDialogOptions{CanChooseDirectories: true})
+	if err != nil {
+		t.Fatalf("SelectDirectory returned unexpected error: %v", err)
+	}
+	if len(paths) != 1 || paths[0] != "/tmp/dir" {
+		t.Fatalf("SelectDirectory() = %v, want [/tmp/dir]", paths)
+	}
+}
+
+func TestDialogMessageBox(t *testing.T) {
+	impl := &fakeDialogImpl{messageBoxIndex: 1}
+	d := &Dialog{impl: impl}
+
+	opts := MessageDialogOptions{
+		Type:    QuestionDialogType,
+		Title:   "Confirm",
+		Message: "Are you sure?",
+		Buttons: []string{"Yes", "No"},
+	}
+	index, err := d.MessageBox(opts)
+	if err != nil {
+		t.Fatalf("MessageBox returned unexpected error: %v", err)
+	}
+	if index != 1 {
+		t.Fatalf("MessageBox() = %d, want 1", index)
+	}
+	if impl.lastMessageOpts.Message != opts.Message {
+		t.Fatalf("messageBox received %+v, want %+v", impl.lastMessageOpts, opts)
+	}
+}
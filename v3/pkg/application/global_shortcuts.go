@@ -0,0 +1,143 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Modifier is a bitmask of keyboard modifier keys used by an Accelerator.
+type Modifier uint8
+
+const (
+	ModifierShift Modifier = 1 << iota
+	ModifierControl
+	ModifierAlt
+	// ModifierCmdOrCtrl is Cmd on macOS and Ctrl on Windows/Linux, matching the `CmdOrCtrl`
+	// accelerator token used by Electron.
+	ModifierCmdOrCtrl
+)
+
+// Accelerator is the canonical, parsed form of a shortcut string such as "CmdOrCtrl+Shift+P".
+type Accelerator struct {
+	Modifiers Modifier
+	KeyCode   string
+}
+
+// ParseAccelerator parses a "+"-separated accelerator string, e.g. "CmdOrCtrl+Shift+P", into
+// its canonical Modifiers/KeyCode form. The key code, the last token, is case-sensitive and
+// should be a single character (e.g. "P") or a named key (e.g. "F1", "Space").
+func ParseAccelerator(accelerator string) (Accelerator, error) {
+	parts := strings.Split(accelerator, "+")
+	if len(parts) == 0 || parts[len(parts)-1] == "" {
+		return Accelerator{}, fmt.Errorf("invalid accelerator %q: missing key code", accelerator)
+	}
+
+	var result Accelerator
+	for _, part := range parts[:len(parts)-1] {
+		switch part {
+		case "Shift":
+			result.Modifiers |= ModifierShift
+		case "Control", "Ctrl":
+			result.Modifiers |= ModifierControl
+		case "Alt", "Option":
+			result.Modifiers |= ModifierAlt
+		case "CmdOrCtrl", "CommandOrControl":
+			result.Modifiers |= ModifierCmdOrCtrl
+		default:
+			return Accelerator{}, fmt.Errorf("invalid accelerator %q: unknown modifier %q", accelerator, part)
+		}
+	}
+	result.KeyCode = parts[len(parts)-1]
+	return result, nil
+}
+
+// globalShortcutsImpl is implemented once per platform: RegisterHotKey/WM_HOTKEY on Windows,
+// NSEvent addGlobalMonitorForEventsMatchingMask (or Carbon RegisterEventHotKey) on macOS, and
+// X11 XGrabKey/Wayland compositor portals on Linux.
+type globalShortcutsImpl interface {
+	register(accelerator Accelerator, handler func()) error
+	unregister(accelerator Accelerator)
+	unregisterAll()
+}
+
+// GlobalShortcuts lets the application register keyboard shortcuts that fire regardless of
+// whether any Wails window has focus, mirroring Electron's globalShortcut module. This is the
+// key difference from Options.KeyBindings, which only fires while a WebviewWindow is focused.
+type GlobalShortcuts struct {
+	impl globalShortcutsImpl
+
+	lock sync.RWMutex
+	// handlers is keyed by the canonical, parsed Accelerator rather than the caller's raw
+	// spelling, so e.g. "Ctrl+A" and "Control+A" are recognised as the same shortcut.
+	handlers map[Accelerator]func()
+}
+
+// NewGlobalShortcuts creates a new GlobalShortcuts subsystem.
+func NewGlobalShortcuts() *GlobalShortcuts {
+	return &GlobalShortcuts{
+		handlers: make(map[Accelerator]func()),
+		impl:     newGlobalShortcutsImpl(),
+	}
+}
+
+// Register parses accelerator (e.g. "CmdOrCtrl+Shift+P") and arranges for handler to be called
+// whenever it is pressed, system-wide, regardless of window focus.
+func (g *GlobalShortcuts) Register(accelerator string, handler func()) error {
+	parsed, err := ParseAccelerator(accelerator)
+	if err != nil {
+		return err
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if _, exists := g.handlers[parsed]; exists {
+		return fmt.Errorf("accelerator %q is already registered", accelerator)
+	}
+	if err := g.impl.register(parsed, handler); err != nil {
+		return err
+	}
+	g.handlers[parsed] = handler
+	return nil
+}
+
+// Unregister removes a previously registered accelerator. It is a no-op if accelerator was
+// never registered or fails to parse.
+func (g *GlobalShortcuts) Unregister(accelerator string) {
+	parsed, err := ParseAccelerator(accelerator)
+	if err != nil {
+		return
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if _, ok := g.handlers[parsed]; !ok {
+		return
+	}
+	g.impl.unregister(parsed)
+	delete(g.handlers, parsed)
+}
+
+// IsRegistered reports whether accelerator currently has a handler registered.
+func (g *GlobalShortcuts) IsRegistered(accelerator string) bool {
+	parsed, err := ParseAccelerator(accelerator)
+	if err != nil {
+		return false
+	}
+
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	_, ok := g.handlers[parsed]
+	return ok
+}
+
+// UnregisterAll removes every accelerator registered through this GlobalShortcuts instance.
+func (g *GlobalShortcuts) UnregisterAll() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.impl.unregisterAll()
+	g.handlers = make(map[Accelerator]func())
+}
@@ -0,0 +1,135 @@
+package application
+
+import "testing"
+
+func TestParseAccelerator(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Accelerator
+		wantErr bool
+	}{
+		{
+			name:  "single key, no modifiers",
+			input: "P",
+			want:  Accelerator{KeyCode: "P"},
+		},
+		{
+			name:  "single modifier",
+			input: "Shift+P",
+			want:  Accelerator{Modifiers: ModifierShift, KeyCode: "P"},
+		},
+		{
+			name:  "multiple modifiers",
+			input: "CmdOrCtrl+Shift+P",
+			want:  Accelerator{Modifiers: ModifierCmdOrCtrl | ModifierShift, KeyCode: "P"},
+		},
+		{
+			name:  "Ctrl and Control are equivalent",
+			input: "Ctrl+A",
+			want:  Accelerator{Modifiers: ModifierControl, KeyCode: "A"},
+		},
+		{
+			name:  "Control spelled out parses the same as Ctrl",
+			input: "Control+A",
+			want:  Accelerator{Modifiers: ModifierControl, KeyCode: "A"},
+		},
+		{
+			name:    "empty string",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "trailing plus has no key code",
+			input:   "Shift+",
+			wantErr: true,
+		},
+		{
+			name:    "unknown modifier",
+			input:   "Super+P",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAccelerator(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAccelerator(%q) = %+v, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAccelerator(%q) returned unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ParseAccelerator(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeGlobalShortcutsImpl is a stand-in for the platform backend, used to exercise
+// GlobalShortcuts' bookkeeping without a real OS-level hotkey registration.
+type fakeGlobalShortcutsImpl struct {
+	registered map[Accelerator]bool
+}
+
+func (f *fakeGlobalShortcutsImpl) register(accelerator Accelerator, handler func()) error {
+	if f.registered == nil {
+		f.registered = make(map[Accelerator]bool)
+	}
+	f.registered[accelerator] = true
+	return nil
+}
+
+func (f *fakeGlobalShortcutsImpl) unregister(accelerator Accelerator) {
+	delete(f.registered, accelerator)
+}
+
+func (f *fakeGlobalShortcutsImpl) unregisterAll() {
+	f.registered = make(map[Accelerator]bool)
+}
+
+func newTestGlobalShortcuts() *GlobalShortcuts {
+	return &GlobalShortcuts{
+		handlers: make(map[Accelerator]func()),
+		impl:     &fakeGlobalShortcutsImpl{},
+	}
+}
+
+func TestGlobalShortcutsRegisterNormalizesSpelling(t *testing.T) {
+	g := newTestGlobalShortcuts()
+
+	if err := g.Register("Ctrl+A", func() {}); err != nil {
+		t.Fatalf("Register(%q) returned unexpected error: %v", "Ctrl+A", err)
+	}
+
+	if err := g.Register("Control+A", func() {}); err == nil {
+		t.Fatalf("Register(%q) succeeded, want error because it is the same accelerator as %q", "Control+A", "Ctrl+A")
+	}
+
+	if !g.IsRegistered("Control+A") {
+		t.Fatalf("IsRegistered(%q) = false, want true since it is the same accelerator as %q", "Control+A", "Ctrl+A")
+	}
+}
+
+func TestGlobalShortcutsUnregister(t *testing.T) {
+	g := newTestGlobalShortcuts()
+
+	if err := g.Register("Ctrl+A", func() {}); err != nil {
+		t.Fatalf("Register returned unexpected error: %v", err)
+	}
+
+	g.Unregister("Control+A")
+
+	if g.IsRegistered("Ctrl+A") {
+		t.Fatalf("IsRegistered(%q) = true after Unregister(%q), want false", "Ctrl+A", "Control+A")
+	}
+
+	// Re-registering the same accelerator, spelled differently again, must now succeed.
+	if err := g.Register("Control+A", func() {}); err != nil {
+		t.Fatalf("Register after Unregister returned unexpected error: %v", err)
+	}
+}
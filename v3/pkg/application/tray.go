@@ -0,0 +1,160 @@
+package application
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// trayImpl is implemented once per platform: NSStatusItem on macOS, Shell_NotifyIcon on
+// Windows, and AppIndicator/StatusNotifierItem on Linux.
+type trayImpl interface {
+	run() error
+	setIcon(icon []byte)
+	setTooltip(tooltip string)
+	setMenu(menu *Menu)
+	setTitle(title string)
+}
+
+// TrayOptions configures a Tray created with NewTray.
+type TrayOptions struct {
+	// Icon is the image data shown in the status bar/notification area.
+	Icon []byte
+
+	// Tooltip is shown when the user hovers over the tray icon.
+	Tooltip string
+
+	// Menu is shown when the user clicks (or, on some platforms, right-clicks) the tray icon.
+	Menu *Menu
+}
+
+// Menu is a hierarchical menu, used both for the Tray and for native window menus.
+type Menu struct {
+	Label       string
+	Accelerator string
+	Checked     bool
+	Enabled     bool
+	Submenu     []*Menu
+	Click       func()
+}
+
+var trayID atomic.Uint64
+
+// Tray is a system tray / status-item icon. This is the capability that makes
+// ActivationPolicyAccessory useful: an application with no main window but a Tray remains
+// visible and interactive via the status bar/notification area.
+type Tray struct {
+	id   uint64
+	impl trayImpl
+
+	options TrayOptions
+
+	handlersLock  sync.RWMutex
+	onClick       func()
+	onRightClick  func()
+	onDoubleClick func()
+}
+
+// NewTray creates a new Tray with the given options. Call Run to show it.
+func NewTray(options TrayOptions) *Tray {
+	id := trayID.Load()
+	trayID.Add(1)
+	return &Tray{
+		id:      id,
+		options: options,
+	}
+}
+
+// Run shows the tray icon.
+func (t *Tray) Run() error {
+	t.impl = newTrayImpl(t)
+	return t.impl.run()
+}
+
+// SetIcon replaces the tray icon.
+func (t *Tray) SetIcon(icon []byte) {
+	t.options.Icon = icon
+	if t.impl == nil {
+		return
+	}
+	t.impl.setIcon(icon)
+}
+
+// SetTooltip replaces the tray tooltip.
+func (t *Tray) SetTooltip(tooltip string) {
+	t.options.Tooltip = tooltip
+	if t.impl == nil {
+		return
+	}
+	t.impl.setTooltip(tooltip)
+}
+
+// SetMenu replaces the menu shown when the tray icon is activated.
+func (t *Tray) SetMenu(menu *Menu) {
+	t.options.Menu = menu
+	if t.impl == nil {
+		return
+	}
+	t.impl.setMenu(menu)
+}
+
+// SetTitle sets the text shown next to the tray icon in the macOS status bar. It has no effect
+// on Windows or Linux.
+func (t *Tray) SetTitle(title string) {
+	if t.impl == nil {
+		return
+	}
+	t.impl.setTitle(title)
+}
+
+// OnClick registers handler to be called when the tray icon is clicked (left-click on
+// Windows/Linux, click on macOS).
+func (t *Tray) OnClick(handler func()) {
+	t.handlersLock.Lock()
+	defer t.handlersLock.Unlock()
+	t.onClick = handler
+}
+
+// OnRightClick registers handler to be called when the tray icon is right-clicked.
+func (t *Tray) OnRightClick(handler func()) {
+	t.handlersLock.Lock()
+	defer t.handlersLock.Unlock()
+	t.onRightClick = handler
+}
+
+// OnDoubleClick registers handler to be called when the tray icon is double-clicked.
+func (t *Tray) OnDoubleClick(handler func()) {
+	t.handlersLock.Lock()
+	defer t.handlersLock.Unlock()
+	t.onDoubleClick = handler
+}
+
+// dispatchClick invokes the handler registered with OnClick, if any. Platform backends call
+// this from their native event-loop callback when the tray icon is clicked.
+func (t *Tray) dispatchClick() {
+	t.handlersLock.RLock()
+	handler := t.onClick
+	t.handlersLock.RUnlock()
+	if handler != nil {
+		handler()
+	}
+}
+
+// dispatchRightClick invokes the handler registered with OnRightClick, if any.
+func (t *Tray) dispatchRightClick() {
+	t.handlersLock.RLock()
+	handler := t.onRightClick
+	t.handlersLock.RUnlock()
+	if handler != nil {
+		handler()
+	}
+}
+
+// dispatchDoubleClick invokes the handler registered with OnDoubleClick, if any.
+func (t *Tray) dispatchDoubleClick() {
+	t.handlersLock.RLock()
+	handler := t.onDoubleClick
+	t.handlersLock.RUnlock()
+	if handler != nil {
+		handler()
+	}
+}
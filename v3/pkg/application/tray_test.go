@@ -0,0 +1,63 @@
+package application
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestTrayOnClickDispatch(t *testing.T) {
+	tray := NewTray(TrayOptions{})
+
+	called := false
+	tray.OnClick(func() { called = true })
+	tray.dispatchClick()
+
+	if !called {
+		t.Fatalf("dispatchClick() did not invoke the handler registered with OnClick")
+	}
+}
+
+func TestTrayDispatchWithNoHandlerIsNoop(t *testing.T) {
+	tray := NewTray(TrayOptions{})
+
+	// Must not panic when no handler has been registered.
+	tray.dispatchClick()
+	tray.dispatchRightClick()
+	tray.dispatchDoubleClick()
+}
+
+func TestTrayHandlersAreIndependent(t *testing.T) {
+	tray := NewTray(TrayOptions{})
+
+	var clicks, rightClicks, doubleClicks int
+	tray.OnClick(func() { clicks++ })
+	tray.OnRightClick(func() { rightClicks++ })
+	tray.OnDoubleClick(func() { doubleClicks++ })
+
+	tray.dispatchRightClick()
+
+	if clicks != 0 || doubleClicks != 0 {
+		t.Fatalf("dispatchRightClick() triggered unrelated handlers: clicks=%d doubleClicks=%d, want 0/0", clicks, doubleClicks)
+	}
+	if rightClicks != 1 {
+		t.Fatalf("rightClicks = %d, want 1", rightClicks)
+	}
+}
+
+func TestTrayConcurrentRegisterAndDispatch(t *testing.T) {
+	tray := NewTray(TrayOptions{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			tray.OnClick(func() {})
+		}()
+		go func() {
+			defer wg.Done()
+			tray.dispatchClick()
+		}()
+	}
+	wg.Wait()
+}
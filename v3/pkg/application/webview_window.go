@@ -0,0 +1,90 @@
+package application
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// webviewImpl is implemented once per platform: WebView2's AddWebResourceRequestedFilter on
+// Windows, WKWebView's setURLSchemeHandler on macOS, and WebKitGTK's register_uri_scheme on
+// Linux.
+type webviewImpl interface {
+	run() error
+	// registerScheme arranges for requests against scheme://... to be synthesized into an
+	// http.Request and routed to handler, with handler's http.Response streamed back to the
+	// WebView.
+	registerScheme(scheme string, handler http.Handler)
+}
+
+// WebviewWindowOptions configures a WebviewWindow created with NewWebviewWindow.
+type WebviewWindowOptions struct {
+	Title string
+
+	// Assets are the assets to be used by this window.
+	Assets AssetOptions
+}
+
+var webviewWindowID atomic.Uint64
+
+// WebviewWindow is a single native window hosting a webview.
+type WebviewWindow struct {
+	id   uint64
+	impl webviewImpl
+
+	assetOptions *AssetOptions
+
+	schemesLock sync.RWMutex
+	schemes     map[string]http.Handler
+}
+
+// NewWebviewWindow creates a new WebviewWindow. Any schemes set on options.Assets.CustomSchemes
+// are registered immediately; call RegisterScheme afterwards to add more.
+func NewWebviewWindow(options WebviewWindowOptions) *WebviewWindow {
+	id := webviewWindowID.Load()
+	webviewWindowID.Add(1)
+
+	w := &WebviewWindow{
+		id:           id,
+		assetOptions: &options.Assets,
+		schemes:      make(map[string]http.Handler, len(options.Assets.CustomSchemes)),
+	}
+	for scheme, handler := range options.Assets.CustomSchemes {
+		w.schemes[scheme] = handler
+	}
+	return w
+}
+
+// Run creates the native window and its webview, wiring up every registered scheme handler.
+func (w *WebviewWindow) Run() error {
+	w.impl = newWebviewImpl(w)
+
+	w.schemesLock.RLock()
+	for scheme, handler := range w.schemes {
+		w.impl.registerScheme(scheme, handler)
+	}
+	w.schemesLock.RUnlock()
+
+	return w.impl.run()
+}
+
+// RegisterScheme registers a custom URI scheme (e.g. "db", "media", "plugin-xyz") for this
+// window only. Requests made by the WebView against scheme://... are routed to handler instead
+// of being resolved as a normal web request. The platform WebView backend (WebView2's
+// AddWebResourceRequestedFilter, WKWebView's setURLSchemeHandler, WebKitGTK's
+// register_uri_scheme) synthesizes an http.Request from the intercepted call and streams
+// handler's http.Response back to the WebView.
+func (w *WebviewWindow) RegisterScheme(scheme string, handler http.Handler) error {
+	w.schemesLock.Lock()
+	defer w.schemesLock.Unlock()
+
+	if _, exists := w.schemes[scheme]; exists {
+		return fmt.Errorf("scheme %q is already registered", scheme)
+	}
+	w.schemes[scheme] = handler
+	if w.impl != nil {
+		w.impl.registerScheme(scheme, handler)
+	}
+	return nil
+}
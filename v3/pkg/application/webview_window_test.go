@@ -0,0 +1,58 @@
+package application
+
+import (
+	"net/http"
+	"testing"
+)
+
+type fakeWebviewImpl struct {
+	registered map[string]http.Handler
+}
+
+func (f *fakeWebviewImpl) run() error { return nil }
+
+func (f *fakeWebviewImpl) registerScheme(scheme string, handler http.Handler) {
+	if f.registered == nil {
+		f.registered = make(map[string]http.Handler)
+	}
+	f.registered[scheme] = handler
+}
+
+func TestNewWebviewWindowRegistersCustomSchemesFromOptions(t *testing.T) {
+	handler := http.NotFoundHandler()
+	w := NewWebviewWindow(WebviewWindowOptions{
+		Assets: AssetOptions{
+			CustomSchemes: map[string]http.Handler{"db": handler},
+		},
+	})
+
+	if got := w.schemes["db"]; got == nil {
+		t.Fatalf(`schemes["db"] = nil, want the handler passed via AssetOptions.CustomSchemes`)
+	}
+}
+
+func TestWebviewWindowRegisterSchemeRejectsDuplicate(t *testing.T) {
+	w := NewWebviewWindow(WebviewWindowOptions{})
+
+	if err := w.RegisterScheme("media", http.NotFoundHandler()); err != nil {
+		t.Fatalf("RegisterScheme(%q) returned unexpected error: %v", "media", err)
+	}
+
+	if err := w.RegisterScheme("media", http.NotFoundHandler()); err == nil {
+		t.Fatalf("RegisterScheme(%q) succeeded twice, want error on second registration", "media")
+	}
+}
+
+func TestWebviewWindowRegisterSchemeAfterRunForwardsToImpl(t *testing.T) {
+	w := NewWebviewWindow(WebviewWindowOptions{})
+	impl := &fakeWebviewImpl{}
+	w.impl = impl
+
+	if err := w.RegisterScheme("plugin-xyz", http.NotFoundHandler()); err != nil {
+		t.Fatalf("RegisterScheme returned unexpected error: %v", err)
+	}
+
+	if impl.registered["plugin-xyz"] == nil {
+		t.Fatalf("registerScheme was not forwarded to the running webviewImpl")
+	}
+}